@@ -0,0 +1,168 @@
+// Command iban-registry reads data/countries.json, a data-driven description
+// of every supported country's BBAN layout, and emits
+// zz_generated_countries.go: the typed per-country registry that package
+// iban consults at runtime.
+//
+// Run it from the repository root whenever data/countries.json changes:
+//
+//	go run ./cmd/iban-registry
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	dataFile   = "data/countries.json"
+	outputFile = "zz_generated_countries.go"
+)
+
+// countryDef mirrors one entry of data/countries.json.
+type countryDef struct {
+	Code   string   `json:"code"`
+	Name   string   `json:"name"`
+	SEPA   bool     `json:"sepa"`
+	Fields []string `json:"fields"`
+}
+
+// field is a single parsed entry of a countryDef's Fields list, e.g.
+// "branch:4!n" parses to {Role: "branch", Length: 4, Class: 'n'}.
+type field struct {
+	Role   string
+	Length int
+	Class  byte
+}
+
+func main() {
+	raw, err := ioutil.ReadFile(dataFile)
+	if err != nil {
+		panic(fmt.Errorf("iban-registry: reading %s: %w", dataFile, err))
+	}
+
+	var defs []countryDef
+	if err := json.Unmarshal(raw, &defs); err != nil {
+		panic(fmt.Errorf("iban-registry: parsing %s: %w", dataFile, err))
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Code < defs[j].Code })
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by cmd/iban-registry from data/countries.json; DO NOT EDIT.\n\n")
+	buf.WriteString("package iban\n\n")
+	buf.WriteString("var generatedCountries = map[string]countryEntry{\n")
+
+	for _, def := range defs {
+		fields, err := parseFields(def.Fields)
+		if err != nil {
+			panic(fmt.Errorf("iban-registry: country %s: %w", def.Code, err))
+		}
+		writeCountry(&buf, def, fields)
+	}
+
+	buf.WriteString("}\n")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(fmt.Errorf("iban-registry: formatting generated source: %w", err))
+	}
+
+	if err := ioutil.WriteFile(outputFile, formatted, 0644); err != nil {
+		panic(fmt.Errorf("iban-registry: writing %s: %w", outputFile, err))
+	}
+}
+
+// parseFields parses the "role:length!class" tokens of a countryDef.
+func parseFields(raw []string) ([]field, error) {
+	fields := make([]field, 0, len(raw))
+	for _, token := range raw {
+		parts := strings.SplitN(token, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("malformed field %q", token)
+		}
+		spec := parts[1]
+		bang := strings.Index(spec, "!")
+		if bang < 0 {
+			return nil, fmt.Errorf("malformed field %q", token)
+		}
+		length, err := strconv.Atoi(spec[:bang])
+		if err != nil {
+			return nil, fmt.Errorf("malformed field %q: %w", token, err)
+		}
+		class := spec[bang+1:]
+		if len(class) != 1 || !strings.ContainsRune("nac", rune(class[0])) {
+			return nil, fmt.Errorf("malformed field %q: unknown class %q", token, class)
+		}
+		fields = append(fields, field{Role: parts[0], Length: length, Class: class[0]})
+	}
+	return fields, nil
+}
+
+// specField returns the CountrySpec field name that a given field role
+// populates, or "" if the role carries no dedicated field.
+func specField(role string) string {
+	switch role {
+	case "bank":
+		return "BankCode"
+	case "branch":
+		return "BranchCode"
+	case "sort":
+		return "SortCode"
+	case "check":
+		return "NationalCheckDigit"
+	case "account":
+		return "AccountNumber"
+	default:
+		return ""
+	}
+}
+
+func classConst(class byte) string {
+	switch class {
+	case 'n':
+		return "classNumeric"
+	case 'a':
+		return "classAlpha"
+	default:
+		return "classAlphaNumeric"
+	}
+}
+
+func writeCountry(buf *bytes.Buffer, def countryDef, fields []field) {
+	var bbanFormat strings.Builder
+	fieldRanges := map[string][2]int{}
+	pos := 0
+
+	fmt.Fprintf(buf, "\t%q: {\n", def.Code)
+	buf.WriteString("\t\tsegments: []segment{\n")
+	for _, f := range fields {
+		bbanFormat.WriteString(strconv.Itoa(f.Length) + "!" + string(f.Class))
+		fmt.Fprintf(buf, "\t\t\t{Start: %d, End: %d, Class: %s},\n", pos, pos+f.Length, classConst(f.Class))
+		// Positions inside CountrySpec are relative to the full,
+		// unspaced IBAN, so they're offset by the 4-character
+		// country code + checksum prefix.
+		if name := specField(f.Role); name != "" {
+			fieldRanges[name] = [2]int{pos + 4, pos + f.Length + 4}
+		}
+		pos += f.Length
+	}
+	buf.WriteString("\t\t},\n")
+	buf.WriteString("\t\tSpec: CountrySpec{\n")
+	fmt.Fprintf(buf, "\t\t\tCode:       %q,\n", def.Code)
+	fmt.Fprintf(buf, "\t\t\tName:       %q,\n", def.Name)
+	fmt.Fprintf(buf, "\t\t\tSEPA:       %t,\n", def.SEPA)
+	fmt.Fprintf(buf, "\t\t\tLength:     %d,\n", pos+4)
+	fmt.Fprintf(buf, "\t\t\tBBANFormat: %q,\n", bbanFormat.String())
+	for _, name := range []string{"BankCode", "BranchCode", "SortCode", "NationalCheckDigit", "AccountNumber"} {
+		if r, ok := fieldRanges[name]; ok {
+			fmt.Fprintf(buf, "\t\t\t%s: FieldRange{Start: %d, End: %d},\n", name, r[0], r[1])
+		}
+	}
+	buf.WriteString("\t\t},\n")
+	buf.WriteString("\t},\n")
+}
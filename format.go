@@ -0,0 +1,115 @@
+package iban
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CaseOption controls how Format renders letters in its output.
+type CaseOption int
+
+const (
+	// Preserve leaves the input's case untouched.
+	Preserve CaseOption = iota
+	// Upper renders letters as upper-case.
+	Upper
+	// Lower renders letters as lower-case.
+	Lower
+)
+
+// FormatOptions controls how Format renders an IBAN number for display.
+type FormatOptions struct {
+	// GroupSize is the number of characters per group. Zero defaults to 4.
+	GroupSize int
+	// Separator is inserted between groups. Empty defaults to a single space.
+	Separator string
+	// Case controls how letters are rendered. Defaults to Preserve.
+	Case CaseOption
+
+	// MaskCharacter, if non-zero, replaces every character outside of
+	// RevealPrefix/RevealSuffix with itself - e.g. '*' to mask an IBAN
+	// for display in UIs and logs while still showing enough of it to be
+	// recognizable.
+	MaskCharacter rune
+	// RevealPrefix is the number of leading characters left unmasked.
+	RevealPrefix int
+	// RevealSuffix is the number of trailing characters left unmasked.
+	RevealSuffix int
+}
+
+// Format renders number (an IBAN, with or without existing spaces) for
+// display, according to opts. The zero value of FormatOptions groups in
+// fours separated by spaces, with no case conversion or masking - the same
+// layout splitTo4 produces.
+func Format(number string, opts FormatOptions) (string, error) {
+	clean := removeSpaces(number)
+	if clean == "" {
+		return "", fmt.Errorf("IBAN: empty IBAN number passed")
+	}
+
+	switch opts.Case {
+	case Upper:
+		clean = strings.ToUpper(clean)
+	case Lower:
+		clean = strings.ToLower(clean)
+	}
+
+	masked := mask(clean, opts)
+
+	groupSize := opts.GroupSize
+	if groupSize == 0 {
+		groupSize = 4
+	}
+	separator := opts.Separator
+	if separator == "" {
+		separator = " "
+	}
+
+	return group(masked, groupSize, separator), nil
+}
+
+// Masked returns i.Number formatted for safe display in logs: grouped in
+// fours, with everything but the first and last 4 characters replaced by
+// '*'.
+func (i IBAN) Masked() string {
+	formatted, _ := Format(i.Number, FormatOptions{
+		MaskCharacter: '*',
+		RevealPrefix:  4,
+		RevealSuffix:  4,
+	})
+	return formatted
+}
+
+func mask(s string, opts FormatOptions) string {
+	if opts.MaskCharacter == 0 {
+		return s
+	}
+
+	runes := []rune(s)
+	for i := opts.RevealPrefix; i < len(runes)-opts.RevealSuffix; i++ {
+		if i >= 0 {
+			runes[i] = opts.MaskCharacter
+		}
+	}
+	return string(runes)
+}
+
+func group(s string, size int, separator string) string {
+	if size <= 0 {
+		return s
+	}
+
+	runes := []rune(s)
+	var b strings.Builder
+	for i := 0; i < len(runes); i += size {
+		end := i + size
+		if end > len(runes) {
+			end = len(runes)
+		}
+		if i > 0 {
+			b.WriteString(separator)
+		}
+		b.WriteString(string(runes[i:end]))
+	}
+	return b.String()
+}
@@ -0,0 +1,41 @@
+package iban_test
+
+import (
+	"testing"
+
+	"github.com/JoinVerse/iban"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDefaultMatchesLegacyGrouping(t *testing.T) {
+	formatted, err := iban.Format("LU280019400644750000", iban.FormatOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "LU28 0019 4006 4475 0000", formatted)
+}
+
+func TestFormatGroupSizeAndSeparator(t *testing.T) {
+	formatted, err := iban.Format("LU280019400644750000", iban.FormatOptions{GroupSize: 2, Separator: "-"})
+	assert.NoError(t, err)
+	assert.Equal(t, "LU-28-00-19-40-06-44-75-00-00", formatted)
+}
+
+func TestFormatMasking(t *testing.T) {
+	formatted, err := iban.Format("LU280019400644750000", iban.FormatOptions{
+		MaskCharacter: '*',
+		RevealPrefix:  4,
+		RevealSuffix:  4,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "LU28 **** **** **** 0000", formatted)
+}
+
+func TestFormatEmpty(t *testing.T) {
+	_, err := iban.Format("", iban.FormatOptions{})
+	assert.Error(t, err)
+}
+
+func TestMasked(t *testing.T) {
+	result, err := iban.NewIBAN("LU28 0019 4006 4475 0000")
+	assert.NoError(t, err)
+	assert.Equal(t, "LU28 **** **** **** 0000", result.Masked())
+}
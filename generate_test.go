@@ -0,0 +1,27 @@
+package iban_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/JoinVerse/iban"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateProducesValidIBANs(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, spec := range iban.Countries() {
+		generated, err := iban.Generate(spec.Code, r)
+		assert.NoError(t, err)
+
+		ok, _, err := iban.IsCorrectIban(generated.Number, false)
+		assert.NoError(t, err)
+		assert.True(t, ok, "generated IBAN for %s should validate: %s", spec.Code, generated.Number)
+	}
+}
+
+func TestGenerateUnknownCountry(t *testing.T) {
+	_, err := iban.Generate("ZZ", rand.New(rand.NewSource(1)))
+	assert.Error(t, err)
+}
@@ -1,9 +1,13 @@
 // Package iban contains utility functions for working with IBAN account numbers.
 package iban
 
+//go:generate go run ./cmd/iban-registry
+
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -13,14 +17,16 @@ var ErrInvalidIBAN = errors.New("Invalid IBAN number received")
 
 // IBAN represents an IBAN number, split up into its different parts.
 type IBAN struct {
-	Number         string
-	NumberFormated string
-	CountryCode    string
-	Checksum       string
-	BBAN           string
-	BankCode       string
-	SortCode       string
-	AccountNumber  string
+	Number             string
+	NumberFormated     string
+	CountryCode        string
+	Checksum           string
+	BBAN               string
+	BankCode           string
+	BranchCode         string
+	SortCode           string
+	NationalCheckDigit string
+	AccountNumber      string
 }
 
 // MarshalText implements encoding/text TextMarshaler interface.
@@ -54,61 +60,129 @@ func NewIBAN(ibanNumber string) (IBAN, error) {
 
 	newIBAN.Number = removeSpaces(ibanNumber)
 	newIBAN.NumberFormated = formatedIBANNumber
-	newIBAN.CountryCode, newIBAN.Checksum, newIBAN.BBAN = splitIbanUp(formatedIBANNumber)
-	newIBAN.BankCode, newIBAN.SortCode, newIBAN.AccountNumber = getBankInfo(newIBAN)
+	newIBAN.CountryCode, newIBAN.Checksum, newIBAN.BBAN = splitIbanUp(newIBAN.Number)
+	newIBAN.BankCode, newIBAN.BranchCode, newIBAN.SortCode, newIBAN.NationalCheckDigit, newIBAN.AccountNumber = getBankInfo(newIBAN)
 
 	return newIBAN, nil
 }
 
-func getBankInfo(iban IBAN) (bankCode string, sortCode string, accountNumber string) {
-	bankCode = ""
-	sortCode = ""
-	accountNumber = ""
+// getBankInfo slices the bank code, branch code, sort code, national check
+// digit and account number directly out of the unspaced IBAN, using the
+// country's field positions. Any field a country doesn't have (e.g. Spain
+// has no SortCode) comes back empty.
+func getBankInfo(iban IBAN) (bankCode, branchCode, sortCode, nationalCheckDigit, accountNumber string) {
+	entry, ok := generatedCountries[iban.CountryCode]
+	if !ok {
+		return "", "", "", "", ""
+	}
 
-	for _, code := range countryList {
-		if code.code == iban.CountryCode {
-			// Bank code
-			bFirstIndex := strings.Index(code.ibanFields, "b")
-			bLastIndex := strings.LastIndex(code.ibanFields, "b")
-			if bFirstIndex >= 0 && bLastIndex >= 0 {
-				bankCode = removeSpaces(iban.NumberFormated[bFirstIndex : bLastIndex+1])
-			}
+	spec := entry.Spec
+	return spec.BankCode.slice(iban.Number), spec.BranchCode.slice(iban.Number),
+		spec.SortCode.slice(iban.Number), spec.NationalCheckDigit.slice(iban.Number),
+		spec.AccountNumber.slice(iban.Number)
+}
 
-			// Sort code
-			sFirstIndex := strings.Index(code.ibanFields, "s")
-			sLastIndex := strings.LastIndex(code.ibanFields, "s")
-			if sFirstIndex >= 0 && sLastIndex >= 0 {
-				sortCode = removeSpaces(iban.NumberFormated[sFirstIndex : sLastIndex+1])
-			}
+// segmentClass identifies the character class allowed within a segment, per
+// the SWIFT IBAN structure notation: 'n' (digits), 'a' (upper-case letters)
+// or 'c' (alphanumeric).
+type segmentClass byte
 
-			// Account number
-			cFirstIndex := strings.Index(code.ibanFields, "c")
-			cLastIndex := strings.LastIndex(code.ibanFields, "c")
-			if cFirstIndex >= 0 && cLastIndex >= 0 {
-				accountNumber = removeSpaces(iban.NumberFormated[cFirstIndex : cLastIndex+1])
-			}
+const (
+	classNumeric      segmentClass = 'n'
+	classAlpha        segmentClass = 'a'
+	classAlphaNumeric segmentClass = 'c'
+)
+
+// segment is a fixed-width, half-open range of positions within a BBAN.
+type segment struct {
+	Start int
+	End   int
+	Class segmentClass
+}
+
+// FieldRange is a half-open range of positions within the unspaced IBAN
+// (country code + checksum + BBAN). A zero-value FieldRange (Start == End)
+// means the country has no such field.
+type FieldRange struct {
+	Start int
+	End   int
+}
+
+// slice returns the substring of number covered by r, or "" if r is empty.
+func (r FieldRange) slice(number string) string {
+	if r.End <= r.Start {
+		return ""
+	}
+	return number[r.Start:r.End]
+}
+
+// CountrySpec describes the IBAN layout for a single country, as produced by
+// the registry generator (see cmd/iban-registry) from data/countries.json.
+type CountrySpec struct {
+	Code       string
+	Name       string
+	SEPA       bool
+	Length     int
+	BBANFormat string // SWIFT structure notation, e.g. "4!n4!n10!c"
+
+	BankCode           FieldRange
+	BranchCode         FieldRange
+	SortCode           FieldRange
+	NationalCheckDigit FieldRange
+	AccountNumber      FieldRange
+}
+
+// countryEntry is the internal, generated counterpart to CountrySpec: it
+// also carries the data IsCorrectIban needs to validate a BBAN for this
+// country.
+type countryEntry struct {
+	Spec     CountrySpec
+	segments []segment
+}
 
-			return bankCode, sortCode, accountNumber
+// Validate reports whether bban (the IBAN with its country code and
+// checksum removed) matches this country's length and per-segment character
+// class rules.
+func (e countryEntry) Validate(bban string) bool {
+	if len(bban) != e.Spec.Length-4 {
+		return false
+	}
+	for _, seg := range e.segments {
+		for i := seg.Start; i < seg.End; i++ {
+			if !seg.Class.matches(bban[i]) {
+				return false
+			}
 		}
 	}
+	return true
+}
 
-	return bankCode, sortCode, accountNumber
+func (c segmentClass) matches(b byte) bool {
+	switch c {
+	case classNumeric:
+		return b >= '0' && b <= '9'
+	case classAlpha:
+		return b >= 'A' && b <= 'Z'
+	default: // classAlphaNumeric
+		return (b >= '0' && b <= '9') || (b >= 'A' && b <= 'Z')
+	}
 }
 
-type ibanCountry struct {
-	country           string
-	chars             int
-	bbanFormat        string
-	code              string
-	ibanFields        string
-	comment           string
-	standardTreatment bool
+// Countries returns the set of country specs known to the registry, sorted
+// by ISO country code. Use it to introspect which countries this package
+// can validate without hardcoding a copy of the list.
+func Countries() []CountrySpec {
+	specs := make([]CountrySpec, 0, len(generatedCountries))
+	for _, entry := range generatedCountries {
+		specs = append(specs, entry.Spec)
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Code < specs[j].Code })
+	return specs
 }
 
 // IsCorrectIban checks if the given iban number corresponds to the rules of a valid iban number and also
 // returns a properly formated iban number string.
 func IsCorrectIban(iban string, debug bool) (isValid bool, wellFormated string, err error) {
-	var ibanConfig ibanCountry
 	ibanValid := false
 	wellFormated = ""
 	if len(iban) >= 15 { // Minimum length for IBAN
@@ -120,19 +194,16 @@ func IsCorrectIban(iban string, debug bool) (isValid bool, wellFormated string,
 		passedChars := len(iban)
 		passedCode, passedChecksum, passedBban := splitIbanUp(iban)
 
-		ibanConfig = countryList[passedCode]
-
-		if ibanConfig.chars > 0 {
-			if ibanConfig.chars == passedChars {
-				convertedIban := rearrangeIBAN(passedCode, passedChecksum, passedBban)
-				convertedIban = convertCharToNumber(convertedIban)
+		entry, ok := generatedCountries[passedCode]
 
-				if calculateModulo(convertedIban) == 1 {
+		if ok {
+			if entry.Spec.Length == passedChars && entry.Validate(passedBban) {
+				if calculateModulo(passedBban, passedCode, passedChecksum) == 1 {
 					ibanValid = true
 					wellFormated = splitTo4(iban)
 				}
-			} else {
-				return false, wellFormated, fmt.Errorf("IBAN: lenght (%s) does not match configuration length (%s)", strconv.Itoa(passedChars), strconv.Itoa(ibanConfig.chars))
+			} else if entry.Spec.Length != passedChars {
+				return false, wellFormated, fmt.Errorf("IBAN: lenght (%s) does not match configuration length (%s)", strconv.Itoa(passedChars), strconv.Itoa(entry.Spec.Length))
 			}
 
 		} else {
@@ -145,6 +216,35 @@ func IsCorrectIban(iban string, debug bool) (isValid bool, wellFormated string,
 	return ibanValid, wellFormated, nil
 }
 
+// Valid reports whether s is a syntactically and checksum-valid IBAN. It
+// never allocates, unlike NewIBAN, which makes it the right choice for
+// callers that need to validate IBANs in bulk (e.g. batch payment
+// processing) and only need the yes/no answer.
+//
+// s must already be upper-case with no embedded spaces; unlike IsCorrectIban,
+// Valid does not normalize its input.
+func Valid(s string) bool {
+	if len(s) < 15 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !((c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')) {
+			return false
+		}
+	}
+
+	passedCode, passedChecksum, passedBban := splitIbanUp(s)
+
+	entry, ok := generatedCountries[passedCode]
+	if !ok || entry.Spec.Length != len(s) || !entry.Validate(passedBban) {
+		return false
+	}
+
+	return calculateModulo(passedBban, passedCode, passedChecksum) == 1
+}
+
 func splitIbanUp(iban string) (countryCode string, checksum string, bban string) {
 	countryCode = iban[0:2]
 	checksum = iban[2:4]
@@ -175,12 +275,9 @@ func GetIbanChecksum(iban string) (int, error) {
 		iban = strings.ToUpper(strings.Replace(iban, " ", "", -1))
 
 		// Split string up
-		passedCode, passedChecksum, passedBban := splitIbanUp(iban)
-		passedChecksum = "00"
-		convertedIban := rearrangeIBAN(passedCode, passedChecksum, passedBban)
-		convertedIban = convertCharToNumber(convertedIban)
+		passedCode, _, passedBban := splitIbanUp(iban)
 
-		ibanChecksum = 98 - calculateModulo(convertedIban)
+		ibanChecksum = 98 - calculateModulo(passedBban, passedCode, "00")
 
 	} else {
 		return -1, fmt.Errorf("IBAN: incorect IBAN string passed <%s>", iban)
@@ -188,55 +285,40 @@ func GetIbanChecksum(iban string) (int, error) {
 	return ibanChecksum, nil
 }
 
-func convertCharToNumber(value string) string {
-	var temp = []byte(value)
-	var result string
-
-	for _, item := range temp {
-		if !((item < "A"[0]) || (item > "Z"[0])) {
-			item = byte(item - 55)
-			result += strconv.Itoa(int(item))
-		} else {
-			result += string(item)
-		}
-	}
-	return result
-}
-
-func rearrangeIBAN(countryCode string, checksum string, bban string) string {
-	return bban + countryCode + checksum
+// maxFoldRemainder is the largest remainder calculateModulo's running total
+// can hold before folding in one more character (worst case: a letter,
+// which multiplies by 100 and adds at most 35) without overflowing a
+// uint64.
+const maxFoldRemainder uint64 = (math.MaxUint64 - 99) / 100
+
+// calculateModulo computes the ISO 7064 mod-97-10 remainder of an IBAN,
+// streaming over bban, then countryCode, then checksum - the order the
+// checksum algorithm rearranges them in - without ever materializing the
+// fully expanded decimal string the naive implementation built. Each digit
+// folds into the running remainder as itself; each letter folds in as two
+// digits, per ISO 13616 (A=10, ..., Z=35). The remainder is reduced modulo
+// 97 whenever another fold could overflow a uint64.
+func calculateModulo(bban, countryCode, checksum string) int {
+	var remainder uint64
+	remainder = foldModulo(remainder, bban)
+	remainder = foldModulo(remainder, countryCode)
+	remainder = foldModulo(remainder, checksum)
+	return int(remainder % 97)
 }
 
-func calculateModulo(iban string) int {
-	exit := false
-	tempIBAN := ""
-	rest := 0
-	for !exit {
-		if len(iban) > 9 {
-			tempIBAN = iban[0:9]
-
-			value, err := strconv.Atoi(tempIBAN)
-			if err != nil {
-				exit = true
-				return -1
-			}
-
-			rest = (value % 97)
-			iban = strconv.Itoa(rest) + iban[9:]
+func foldModulo(remainder uint64, s string) uint64 {
+	for i := 0; i < len(s); i++ {
+		if remainder > maxFoldRemainder {
+			remainder %= 97
+		}
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			remainder = remainder*10 + uint64(c-'0')
 		} else {
-			tempIBAN = iban
-
-			value, err := strconv.Atoi(tempIBAN)
-			if err != nil {
-				exit = true
-				return -1
-			}
-
-			rest = (value % 97)
-			exit = true
+			remainder = remainder*100 + uint64(c-'A'+10)
 		}
 	}
-	return rest
+	return remainder
 }
 
 func removeSpaces(text string) string {
@@ -0,0 +1,42 @@
+package iban_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/JoinVerse/iban"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFieldPositionsReassembleBBAN checks that slicing out every named field
+// in positional order and concatenating them reproduces the BBAN exactly,
+// i.e. the fields partition the BBAN with no gaps or overlaps.
+func TestFieldPositionsReassembleBBAN(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	for _, spec := range iban.Countries() {
+		generated, err := iban.Generate(spec.Code, r)
+		assert.NoError(t, err)
+
+		type rangedValue struct {
+			start int
+			value string
+		}
+		fields := []rangedValue{
+			{spec.BankCode.Start, generated.BankCode},
+			{spec.BranchCode.Start, generated.BranchCode},
+			{spec.SortCode.Start, generated.SortCode},
+			{spec.NationalCheckDigit.Start, generated.NationalCheckDigit},
+			{spec.AccountNumber.Start, generated.AccountNumber},
+		}
+		sort.Slice(fields, func(i, j int) bool { return fields[i].start < fields[j].start })
+
+		reassembled := ""
+		for _, f := range fields {
+			reassembled += f.value
+		}
+
+		assert.Equal(t, generated.BBAN, reassembled, "fields for %s should reassemble the BBAN", spec.Code)
+	}
+}
@@ -0,0 +1,19 @@
+package iban
+
+// IsSEPA reports whether i's country participates in SEPA (the Single Euro
+// Payments Area). Payment routers and compliance filters can use this to
+// reject non-SEPA IBANs before handing them to a SEPA-only payment rail.
+func (i IBAN) IsSEPA() bool {
+	return generatedCountries[i.CountryCode].Spec.SEPA
+}
+
+// CountryName returns the full name of i's issuing country, e.g. "Germany".
+func (i IBAN) CountryName() string {
+	return generatedCountries[i.CountryCode].Spec.Name
+}
+
+// IsSEPACountry reports whether code, an ISO 3166-1 alpha-2 country code,
+// participates in SEPA.
+func IsSEPACountry(code string) bool {
+	return generatedCountries[code].Spec.SEPA
+}
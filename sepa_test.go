@@ -0,0 +1,18 @@
+package iban_test
+
+import (
+	"testing"
+
+	"github.com/JoinVerse/iban"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSEPA(t *testing.T) {
+	es, err := iban.NewIBAN("ES9121000418450200051332")
+	assert.NoError(t, err)
+	assert.True(t, es.IsSEPA())
+	assert.Equal(t, "Spain", es.CountryName())
+
+	assert.True(t, iban.IsSEPACountry("ES"))
+	assert.False(t, iban.IsSEPACountry("MN"))
+}
@@ -0,0 +1,36 @@
+package iban_test
+
+import (
+	"testing"
+
+	"github.com/JoinVerse/iban"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValid(t *testing.T) {
+	assert.True(t, iban.Valid("LU280019400644750000"))
+	assert.True(t, iban.Valid("ES9121000418450200051332"))
+	assert.False(t, iban.Valid("LU123456789012345678"))
+	assert.False(t, iban.Valid("LU2800194006447500"))   // too short for LU
+	assert.False(t, iban.Valid("lu280019400644750000")) // not upper-case, not normalized
+}
+
+func BenchmarkValid(b *testing.B) {
+	const number = "ES9121000418450200051332"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !iban.Valid(number) {
+			b.Fatal("expected a valid IBAN")
+		}
+	}
+}
+
+func BenchmarkNewIBAN(b *testing.B) {
+	const number = "ES9121000418450200051332"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := iban.NewIBAN(number); err != nil {
+			b.Fatal(err)
+		}
+	}
+}